@@ -0,0 +1,133 @@
+package timespan
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Parse: Build a TimeSpan from a compact DSL describing one or more intervals.
+//
+// Supported endpoint forms: absolute timestamps ("2024-01-02T09:00", "09:00"),
+// "now" with an optional signed duration ("now", "now+2h"), and "today"/"yesterday"
+// with an optional time-of-day ("today 09:00", "yesterday"). Two endpoints joined
+// by "--" form a range ("09:00 -- 17:30"); a single endpoint followed by "~" and a
+// duration forms a centered range ("14:00 ~30m" == "13:30 -- 14:30"). Multiple
+// entries can be composed with commas ("09:00--12:00, 13:00--17:00"); the
+// resulting units are merged via NewBulk.
+func Parse(now time.Time, s string) (*TimeSpan, error) {
+	spans := make([][2]time.Time, 0)
+	for _, group := range strings.Split(s, ",") {
+		group = strings.TrimSpace(group)
+		if group == "" {
+			continue
+		}
+		start, end, err := parseGroup(now, group)
+		if err != nil {
+			return nil, fmt.Errorf("timespan: parse %q: %w", group, err)
+		}
+		spans = append(spans, [2]time.Time{start, end})
+	}
+	if len(spans) == 0 {
+		return nil, errors.New("timespan: empty expression")
+	}
+	return NewBulk(spans), nil
+}
+
+// MustParse: Like Parse, but panics if s cannot be parsed
+func MustParse(now time.Time, s string) *TimeSpan {
+	ts, err := Parse(now, s)
+	if err != nil {
+		panic(err)
+	}
+	return ts
+}
+
+// parseGroup: Parse a single "--" range or "~" centered entry
+func parseGroup(now time.Time, group string) (time.Time, time.Time, error) {
+	if idx := strings.Index(group, "--"); idx >= 0 {
+		start, err := parseEndpoint(now, group[:idx])
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		end, err := parseEndpoint(now, group[idx+2:])
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		return start, end, nil
+	}
+	if idx := strings.Index(group, "~"); idx >= 0 {
+		center, err := parseEndpoint(now, group[:idx])
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		delta, err := time.ParseDuration(strings.TrimSpace(group[idx+1:]))
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		return center.Add(-delta), center.Add(delta), nil
+	}
+	return time.Time{}, time.Time{}, errors.New(`timespan: entry needs "--" or "~"`)
+}
+
+// parseEndpoint: Parse one endpoint: now[+-duration], today[ time], yesterday[ time], or a timestamp
+func parseEndpoint(now time.Time, atom string) (time.Time, error) {
+	fields := strings.Fields(atom)
+	if len(fields) == 0 {
+		return time.Time{}, errors.New("timespan: empty endpoint")
+	}
+
+	switch {
+	case strings.HasPrefix(fields[0], "now"):
+		rest := strings.TrimPrefix(fields[0], "now")
+		if rest == "" {
+			return now, nil
+		}
+		d, err := time.ParseDuration(rest)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return now.Add(d), nil
+	case fields[0] == "today":
+		if len(fields) == 2 {
+			return timeOfDayOn(now, fields[1])
+		}
+		return startOfDay(now), nil
+	case fields[0] == "yesterday":
+		yesterday := now.AddDate(0, 0, -1)
+		if len(fields) == 2 {
+			return timeOfDayOn(yesterday, fields[1])
+		}
+		return startOfDay(yesterday), nil
+	default:
+		return parseTimestamp(now, fields[0])
+	}
+}
+
+// parseTimestamp: Parse an absolute timestamp, falling back to a bare time-of-day anchored on now's date
+func parseTimestamp(now time.Time, s string) (time.Time, error) {
+	layouts := []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02T15:04"}
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, s, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+	return timeOfDayOn(now, s)
+}
+
+// timeOfDayOn: Parse a bare "15:04" or "15:04:05" time-of-day and place it on day's date
+func timeOfDayOn(day time.Time, s string) (time.Time, error) {
+	layouts := []string{"15:04:05", "15:04"}
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, s, day.Location()); err == nil {
+			return time.Date(day.Year(), day.Month(), day.Day(), t.Hour(), t.Minute(), t.Second(), 0, day.Location()), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("timespan: invalid time %q", s)
+}
+
+// startOfDay: Return day's date at 00:00:00
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,114 @@
+package timespan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Parse_Absolute(t *testing.T) {
+	now := Time(12, 0, 0)
+
+	ts, err := Parse(now, "09:00 -- 17:30")
+	assert.NoError(t, err)
+
+	s, e, err := ts.StartEnd()
+	assert.NoError(t, err)
+	assert.True(t, s.Equal(Time(9, 0, 0)))
+	assert.True(t, e.Equal(Time(17, 30, 0)))
+}
+
+func Test_Parse_AbsoluteDateTime(t *testing.T) {
+	now := Time(12, 0, 0)
+
+	ts, err := Parse(now, "2024-01-02T09:00 -- 2024-01-02T17:30")
+	assert.NoError(t, err)
+
+	s, e, err := ts.StartEnd()
+	assert.NoError(t, err)
+	assert.True(t, s.Equal(time.Date(2024, 1, 2, 9, 0, 0, 0, now.Location())))
+	assert.True(t, e.Equal(time.Date(2024, 1, 2, 17, 30, 0, 0, now.Location())))
+}
+
+func Test_Parse_Now(t *testing.T) {
+	now := Time(12, 0, 0)
+
+	ts, err := Parse(now, "now -- now+2h")
+	assert.NoError(t, err)
+
+	s, e, err := ts.StartEnd()
+	assert.NoError(t, err)
+	assert.True(t, s.Equal(now))
+	assert.True(t, e.Equal(now.Add(2*time.Hour)))
+}
+
+func Test_Parse_Today(t *testing.T) {
+	now := Time(12, 0, 0)
+
+	ts, err := Parse(now, "today 09:00 -- today 17:00")
+	assert.NoError(t, err)
+
+	s, e, err := ts.StartEnd()
+	assert.NoError(t, err)
+	assert.True(t, s.Equal(Time(9, 0, 0)))
+	assert.True(t, e.Equal(Time(17, 0, 0)))
+}
+
+func Test_Parse_Yesterday(t *testing.T) {
+	now := Time(12, 0, 0)
+
+	ts, err := Parse(now, "yesterday -- now")
+	assert.NoError(t, err)
+
+	s, e, err := ts.StartEnd()
+	assert.NoError(t, err)
+	assert.True(t, s.Equal(startOfDay(now.AddDate(0, 0, -1))))
+	assert.True(t, e.Equal(now))
+}
+
+func Test_Parse_Centered(t *testing.T) {
+	now := Time(12, 0, 0)
+
+	ts, err := Parse(now, "14:00 ~30m")
+	assert.NoError(t, err)
+
+	s, e, err := ts.StartEnd()
+	assert.NoError(t, err)
+	assert.True(t, s.Equal(Time(13, 30, 0)))
+	assert.True(t, e.Equal(Time(14, 30, 0)))
+}
+
+func Test_Parse_Composition(t *testing.T) {
+	now := Time(12, 0, 0)
+
+	ts, err := Parse(now, "09:00--12:00, 13:00--17:00")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, ts.Count())
+	s, e, err := ts.StartEnd()
+	assert.NoError(t, err)
+	assert.True(t, s.Equal(Time(9, 0, 0)))
+	assert.True(t, e.Equal(Time(17, 0, 0)))
+}
+
+func Test_Parse_Invalid(t *testing.T) {
+	now := Time(12, 0, 0)
+
+	_, err := Parse(now, "not a valid entry")
+	assert.True(t, err != nil)
+}
+
+func Test_MustParse_Panics(t *testing.T) {
+	now := Time(12, 0, 0)
+
+	assert.True(t, func() (panicked bool) {
+		defer func() {
+			if recover() != nil {
+				panicked = true
+			}
+		}()
+		MustParse(now, "garbage")
+		return false
+	}())
+}
@@ -0,0 +1,113 @@
+package timespan
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// MarshalText: Implement encoding.TextMarshaler. The result is a JSON array of
+// [start, end] pairs using RFC 3339 timestamps, e.g.
+// [["2024-01-01T09:00:00Z","2024-01-01T10:30:00Z"]].
+func (ts *TimeSpan) MarshalText() ([]byte, error) {
+	return ts.MarshalJSON()
+}
+
+// UnmarshalText: Implement encoding.TextUnmarshaler
+func (ts *TimeSpan) UnmarshalText(text []byte) error {
+	return ts.UnmarshalJSON(text)
+}
+
+// MarshalJSON: Implement json.Marshaler
+func (ts *TimeSpan) MarshalJSON() ([]byte, error) {
+	pairs := make([][2]string, 0, ts.Count())
+	for _, u := range ts.units {
+		pairs = append(pairs, [2]string{u.Start().Format(time.RFC3339), u.End().Format(time.RFC3339)})
+	}
+	return json.Marshal(pairs)
+}
+
+// UnmarshalJSON: Implement json.Unmarshaler
+func (ts *TimeSpan) UnmarshalJSON(data []byte) error {
+	var pairs [][2]string
+	if err := json.Unmarshal(data, &pairs); err != nil {
+		return err
+	}
+	spans := make([][2]time.Time, 0, len(pairs))
+	for _, p := range pairs {
+		start, err := time.Parse(time.RFC3339, p[0])
+		if err != nil {
+			return err
+		}
+		end, err := time.Parse(time.RFC3339, p[1])
+		if err != nil {
+			return err
+		}
+		spans = append(spans, [2]time.Time{start, end})
+	}
+	*ts = *NewBulk(spans)
+	return nil
+}
+
+// MarshalBinary: Implement encoding.BinaryMarshaler. The result is a
+// length-prefixed sequence of time.Time binary encodings.
+func (ts *TimeSpan) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := binary.Write(&buf, binary.BigEndian, uint32(ts.Count())); err != nil {
+		return nil, err
+	}
+	for _, u := range ts.units {
+		for _, t := range []time.Time{u.Start(), u.End()} {
+			b, err := t.MarshalBinary()
+			if err != nil {
+				return nil, err
+			}
+			if err := binary.Write(&buf, binary.BigEndian, uint32(len(b))); err != nil {
+				return nil, err
+			}
+			buf.Write(b)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary: Implement encoding.BinaryUnmarshaler
+func (ts *TimeSpan) UnmarshalBinary(data []byte) error {
+	buf := bytes.NewReader(data)
+	var count uint32
+	if err := binary.Read(buf, binary.BigEndian, &count); err != nil {
+		return err
+	}
+	spans := make([][2]time.Time, 0, count)
+	for i := uint32(0); i < count; i++ {
+		var span [2]time.Time
+		for j := 0; j < 2; j++ {
+			var size uint32
+			if err := binary.Read(buf, binary.BigEndian, &size); err != nil {
+				return err
+			}
+			b := make([]byte, size)
+			if _, err := io.ReadFull(buf, b); err != nil {
+				return err
+			}
+			if err := span[j].UnmarshalBinary(b); err != nil {
+				return err
+			}
+		}
+		spans = append(spans, span)
+	}
+	*ts = *NewBulk(spans)
+	return nil
+}
+
+// GobEncode: Implement gob.GobEncoder
+func (ts *TimeSpan) GobEncode() ([]byte, error) {
+	return ts.MarshalBinary()
+}
+
+// GobDecode: Implement gob.GobDecoder
+func (ts *TimeSpan) GobDecode(data []byte) error {
+	return ts.UnmarshalBinary(data)
+}
@@ -0,0 +1,74 @@
+package timespan
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_JSON(t *testing.T) {
+	ts := New(Time(9, 0, 0), Time(10, 30, 0))
+	ts.Add(New(Time(13, 0, 0), Time(17, 0, 0)))
+
+	b, err := json.Marshal(ts)
+	assert.NoError(t, err)
+
+	var got TimeSpan
+	assert.NoError(t, json.Unmarshal(b, &got))
+
+	s, e, err := got.StartEnd()
+	assert.NoError(t, err)
+	assert.True(t, s.Equal(Time(9, 0, 0)))
+	assert.True(t, e.Equal(Time(17, 0, 0)))
+	assert.Equal(t, 2, got.Count())
+}
+
+func Test_Text(t *testing.T) {
+	ts := New(Time(9, 0, 0), Time(10, 30, 0))
+
+	text, err := ts.MarshalText()
+	assert.NoError(t, err)
+
+	var got TimeSpan
+	assert.NoError(t, got.UnmarshalText(text))
+
+	s, e, err := got.StartEnd()
+	assert.NoError(t, err)
+	assert.True(t, s.Equal(Time(9, 0, 0)))
+	assert.True(t, e.Equal(Time(10, 30, 0)))
+}
+
+func Test_Binary(t *testing.T) {
+	ts := New(Time(9, 0, 0), Time(10, 30, 0))
+	ts.Add(New(Time(13, 0, 0), Time(17, 0, 0)))
+
+	b, err := ts.MarshalBinary()
+	assert.NoError(t, err)
+
+	var got TimeSpan
+	assert.NoError(t, got.UnmarshalBinary(b))
+
+	s, e, err := got.StartEnd()
+	assert.NoError(t, err)
+	assert.True(t, s.Equal(Time(9, 0, 0)))
+	assert.True(t, e.Equal(Time(17, 0, 0)))
+	assert.Equal(t, 2, got.Count())
+}
+
+func Test_Gob(t *testing.T) {
+	ts := New(Time(9, 0, 0), Time(10, 30, 0))
+
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(ts))
+
+	var got TimeSpan
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(&got))
+
+	s, e, err := got.StartEnd()
+	assert.NoError(t, err)
+	assert.True(t, s.Equal(Time(9, 0, 0)))
+	assert.True(t, e.Equal(Time(10, 30, 0)))
+}
@@ -0,0 +1,62 @@
+package timespan
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// durationUnits: units used by FormatDuration, ordered from coarsest to finest
+var durationUnits = []struct {
+	suffix string
+	size   time.Duration
+}{
+	{"y", 365 * 24 * time.Hour},
+	{"w", 7 * 24 * time.Hour},
+	{"d", 24 * time.Hour},
+	{"h", time.Hour},
+	{"m", time.Minute},
+	{"s", time.Second},
+}
+
+// maxDurationParts: maximum number of non-zero components emitted once the value spans a day or more
+const maxDurationParts = 3
+
+// FormatDuration: Render a duration in a compact multi-unit style (e.g. "3y2w1d", "1d2h23m"),
+// dropping zero components. Durations shorter than a day fall back to time.Duration.String().
+func FormatDuration(d time.Duration) string {
+	if d > -24*time.Hour && d < 24*time.Hour {
+		return d.String()
+	}
+
+	neg := d < 0
+	if neg {
+		d = -d
+	}
+
+	var sb strings.Builder
+	parts := 0
+	for _, u := range durationUnits {
+		if d < u.size {
+			continue
+		}
+		n := d / u.size
+		d -= n * u.size
+		sb.WriteString(fmt.Sprintf("%d%s", n, u.suffix))
+		parts++
+		if parts == maxDurationParts {
+			break
+		}
+	}
+
+	s := sb.String()
+	if neg {
+		s = "-" + s
+	}
+	return s
+}
+
+// TotalString: Return the total duration of ts formatted via FormatDuration
+func (ts *TimeSpan) TotalString() string {
+	return FormatDuration(ts.Total())
+}
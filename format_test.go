@@ -0,0 +1,32 @@
+package timespan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FormatDuration(t *testing.T) {
+	cases := []struct {
+		d    time.Duration
+		want string
+	}{
+		{365 * 24 * time.Hour, "1y"},
+		{7 * 24 * time.Hour, "1w"},
+		{1110 * 24 * time.Hour, "3y2w1d"},
+		{time.Duration(1.5 * float64(24*time.Hour)), "1d12h"},
+		{95000 * time.Second, "1d2h23m"},
+		{time.Second, "1s"},
+		{250 * time.Millisecond, "250ms"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.want, FormatDuration(c.d))
+	}
+}
+
+func Test_TotalString(t *testing.T) {
+	ts := New(Time(0, 0, 0), Time(25, 0, 0)) // 25時間 = 1d1h
+
+	assert.Equal(t, "1d1h", ts.TotalString())
+}
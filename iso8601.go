@@ -0,0 +1,251 @@
+package timespan
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// isoComponent: a single numbered element of an ISO 8601 duration, e.g. "3" + "D"
+type isoComponent struct {
+	n    float64
+	unit string
+}
+
+// splitISOComponents: split a date or time half of a "P..." duration (without the
+// leading P or the T separator) into its numbered components
+func splitISOComponents(s string) ([]isoComponent, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var comps []isoComponent
+	var num strings.Builder
+	for _, r := range s {
+		if (r >= '0' && r <= '9') || r == '.' {
+			num.WriteRune(r)
+			continue
+		}
+		if num.Len() == 0 {
+			return nil, fmt.Errorf("timespan: invalid ISO 8601 duration component in %q", s)
+		}
+		n, err := strconv.ParseFloat(num.String(), 64)
+		if err != nil {
+			return nil, fmt.Errorf("timespan: invalid ISO 8601 duration component in %q: %w", s, err)
+		}
+		comps = append(comps, isoComponent{n: n, unit: string(r)})
+		num.Reset()
+	}
+	if num.Len() != 0 {
+		return nil, fmt.Errorf("timespan: trailing digits in ISO 8601 duration %q", s)
+	}
+	return comps, nil
+}
+
+// splitISODuration: split "P<date>T<time>" into its date and time halves
+func splitISODuration(s string) (datePart string, timePart string, err error) {
+	if !strings.HasPrefix(s, "P") {
+		return "", "", fmt.Errorf("timespan: invalid ISO 8601 duration %q", s)
+	}
+	rest := s[1:]
+	if date, t, ok := strings.Cut(rest, "T"); ok {
+		return date, t, nil
+	}
+	return rest, "", nil
+}
+
+// ParseISODuration: Parse a fixed-length ISO 8601 duration of the form
+// P[n]W[n]DT[n]H[n]M[n]S. The year and (date-level) month components are rejected
+// since they are not a fixed length; use ParseISO for those, which anchors them to
+// a concrete date via time.Time.AddDate.
+func ParseISODuration(s string) (time.Duration, error) {
+	datePart, timePart, err := splitISODuration(s)
+	if err != nil {
+		return 0, err
+	}
+
+	dateComps, err := splitISOComponents(datePart)
+	if err != nil {
+		return 0, err
+	}
+	var d time.Duration
+	for _, c := range dateComps {
+		switch c.unit {
+		case "Y":
+			return 0, fmt.Errorf("timespan: ISO 8601 duration %q has a non-fixed year component; use ParseISO instead", s)
+		case "M":
+			return 0, fmt.Errorf("timespan: ISO 8601 duration %q has a non-fixed month component; use ParseISO instead", s)
+		case "W":
+			d += time.Duration(c.n * float64(7*24*time.Hour))
+		case "D":
+			d += time.Duration(c.n * float64(24*time.Hour))
+		default:
+			return 0, fmt.Errorf("timespan: invalid ISO 8601 duration %q", s)
+		}
+	}
+
+	timeComps, err := splitISOComponents(timePart)
+	if err != nil {
+		return 0, err
+	}
+	for _, c := range timeComps {
+		switch c.unit {
+		case "H":
+			d += time.Duration(c.n * float64(time.Hour))
+		case "M":
+			d += time.Duration(c.n * float64(time.Minute))
+		case "S":
+			d += time.Duration(c.n * float64(time.Second))
+		default:
+			return 0, fmt.Errorf("timespan: invalid ISO 8601 duration %q", s)
+		}
+	}
+	return d, nil
+}
+
+// applyISODuration: Apply an ISO 8601 duration (including year/month) to ref via
+// AddDate, moving forward when sign is +1 and backward when sign is -1
+func applyISODuration(ref time.Time, s string, sign int) (time.Time, error) {
+	datePart, timePart, err := splitISODuration(s)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	dateComps, err := splitISOComponents(datePart)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// 年・月は暦に依存するため AddDate に委ね、週・日はそれ以外の時間成分と合わせて
+	// 固定長の time.Duration として扱う（端数を保持するため）
+	var years, months int
+	var d time.Duration
+	for _, c := range dateComps {
+		switch c.unit {
+		case "Y":
+			years += int(c.n)
+		case "M":
+			months += int(c.n)
+		case "W":
+			d += time.Duration(c.n * float64(7*24*time.Hour))
+		case "D":
+			d += time.Duration(c.n * float64(24*time.Hour))
+		default:
+			return time.Time{}, fmt.Errorf("timespan: invalid ISO 8601 duration %q", s)
+		}
+	}
+
+	timeComps, err := splitISOComponents(timePart)
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, c := range timeComps {
+		switch c.unit {
+		case "H":
+			d += time.Duration(c.n * float64(time.Hour))
+		case "M":
+			d += time.Duration(c.n * float64(time.Minute))
+		case "S":
+			d += time.Duration(c.n * float64(time.Second))
+		default:
+			return time.Time{}, fmt.Errorf("timespan: invalid ISO 8601 duration %q", s)
+		}
+	}
+
+	t := ref.AddDate(sign*years, sign*months, 0)
+	return t.Add(time.Duration(sign) * d), nil
+}
+
+// parseISOInterval: Parse a single "<start>/<end>", "<start>/<duration>", or
+// "<duration>/<end>" interval
+func parseISOInterval(s string) (time.Time, time.Time, error) {
+	a, b, ok := strings.Cut(s, "/")
+	if !ok {
+		return time.Time{}, time.Time{}, fmt.Errorf("timespan: invalid ISO 8601 interval %q", s)
+	}
+
+	aIsDuration := strings.HasPrefix(a, "P")
+	bIsDuration := strings.HasPrefix(b, "P")
+
+	switch {
+	case aIsDuration && bIsDuration:
+		return time.Time{}, time.Time{}, fmt.Errorf("timespan: ISO 8601 interval %q cannot have two durations", s)
+	case aIsDuration:
+		end, err := time.Parse(time.RFC3339, b)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		start, err := applyISODuration(end, a, -1)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		return start, end, nil
+	case bIsDuration:
+		start, err := time.Parse(time.RFC3339, a)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		end, err := applyISODuration(start, b, 1)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		return start, end, nil
+	default:
+		start, err := time.Parse(time.RFC3339, a)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		end, err := time.Parse(time.RFC3339, b)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		return start, end, nil
+	}
+}
+
+// ParseISO: Build a TimeSpan from an ISO 8601 interval ("<start>/<end>",
+// "<start>/<duration>", or "<duration>/<end>"), optionally prefixed with a
+// repeat count ("Rn/<interval>") that expands into n consecutive units of the
+// same length. "R/<interval>" (no count, meaning unbounded repetition in the
+// ISO 8601 spec) is not representable as a finite TimeSpan and is treated as a
+// single occurrence.
+func ParseISO(s string) (*TimeSpan, error) {
+	repeat := 1
+	rest := s
+	if strings.HasPrefix(s, "R") {
+		body, interval, ok := strings.Cut(s[1:], "/")
+		if !ok {
+			return nil, fmt.Errorf("timespan: invalid ISO 8601 repeating interval %q", s)
+		}
+		if body != "" {
+			n, err := strconv.Atoi(body)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("timespan: invalid ISO 8601 repeat count in %q", s)
+			}
+			repeat = n
+		}
+		rest = interval
+	}
+
+	start, end, err := parseISOInterval(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	step := end.Sub(start)
+	spans := make([][2]time.Time, 0, repeat)
+	cur := start
+	for i := 0; i < repeat; i++ {
+		spans = append(spans, [2]time.Time{cur, cur.Add(step)})
+		cur = cur.Add(step)
+	}
+	return NewBulk(spans), nil
+}
+
+// FormatISO: Return one "<start>/<end>" RFC 3339 token per unit, comma-joined
+func (ts *TimeSpan) FormatISO() string {
+	tokens := make([]string, 0, ts.Count())
+	for _, u := range ts.units {
+		tokens = append(tokens, u.Start().Format(time.RFC3339)+"/"+u.End().Format(time.RFC3339))
+	}
+	return strings.Join(tokens, ",")
+}
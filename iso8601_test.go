@@ -0,0 +1,98 @@
+package timespan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseISODuration(t *testing.T) {
+	cases := []struct {
+		s    string
+		want time.Duration
+	}{
+		{"PT90M", 90 * time.Minute},
+		{"P1D", 24 * time.Hour},
+		{"P1DT2H30M", 26*time.Hour + 30*time.Minute},
+		{"PT30S", 30 * time.Second},
+	}
+	for _, c := range cases {
+		got, err := ParseISODuration(c.s)
+		assert.NoError(t, err)
+		assert.Equal(t, c.want, got)
+	}
+}
+
+func Test_ParseISODuration_RejectsYearAndMonth(t *testing.T) {
+	_, err := ParseISODuration("P1Y")
+	assert.True(t, err != nil)
+
+	_, err = ParseISODuration("P1M")
+	assert.True(t, err != nil)
+}
+
+func Test_ParseISO_StartEnd(t *testing.T) {
+	ts, err := ParseISO("2024-01-02T09:00:00Z/2024-01-02T10:30:00Z")
+	assert.NoError(t, err)
+
+	s, e, err := ts.StartEnd()
+	assert.NoError(t, err)
+	assert.True(t, s.Equal(time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)))
+	assert.True(t, e.Equal(time.Date(2024, 1, 2, 10, 30, 0, 0, time.UTC)))
+}
+
+func Test_ParseISO_StartDuration(t *testing.T) {
+	ts, err := ParseISO("2024-01-02T09:00:00Z/PT90M")
+	assert.NoError(t, err)
+
+	s, e, err := ts.StartEnd()
+	assert.NoError(t, err)
+	assert.True(t, s.Equal(time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)))
+	assert.True(t, e.Equal(time.Date(2024, 1, 2, 10, 30, 0, 0, time.UTC)))
+}
+
+func Test_ParseISO_DurationEnd(t *testing.T) {
+	ts, err := ParseISO("P1D/2024-01-03T00:00:00Z")
+	assert.NoError(t, err)
+
+	s, e, err := ts.StartEnd()
+	assert.NoError(t, err)
+	assert.True(t, s.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, e.Equal(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)))
+}
+
+func Test_ParseISO_Repeating(t *testing.T) {
+	ts, err := ParseISO("R3/2024-01-01T00:00:00Z/P1D")
+	assert.NoError(t, err)
+
+	// 各区間が接しているため、正規化により1つの連続区間へ統合される
+	assert.Equal(t, 1, ts.Count())
+	s, e, err := ts.StartEnd()
+	assert.NoError(t, err)
+	assert.True(t, s.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, e.Equal(time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC)))
+}
+
+func Test_ParseISO_FractionalDuration(t *testing.T) {
+	ts, err := ParseISO("2024-01-02T09:00:00Z/P1.5D")
+	assert.NoError(t, err)
+
+	s, e, err := ts.StartEnd()
+	assert.NoError(t, err)
+	assert.True(t, s.Equal(time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)))
+	assert.True(t, e.Equal(time.Date(2024, 1, 3, 21, 0, 0, 0, time.UTC)))
+}
+
+func Test_ParseISO_NegativeRepeatCount(t *testing.T) {
+	_, err := ParseISO("R-1/2024-01-01T00:00:00Z/P1D")
+	assert.True(t, err != nil)
+}
+
+func Test_FormatISO(t *testing.T) {
+	ts := New(time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC), time.Date(2024, 1, 2, 10, 30, 0, 0, time.UTC))
+	ts.Add(New(time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC), time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC)))
+
+	want := "2024-01-02T09:00:00Z/2024-01-02T10:30:00Z,2024-01-03T09:00:00Z/2024-01-03T10:00:00Z"
+	assert.Equal(t, want, ts.FormatISO())
+}
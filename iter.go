@@ -0,0 +1,61 @@
+package timespan
+
+import "time"
+
+// Each: Walk each unit in order, calling fn(i, start, end). Stops early if fn returns false.
+func (ts *TimeSpan) Each(fn func(i int, start, end time.Time) bool) {
+	for i, u := range ts.units {
+		if !fn(i, u.Start(), u.End()) {
+			return
+		}
+	}
+}
+
+// At: Return the i-th unit's start and end. ok is false if i is out of range.
+func (ts *TimeSpan) At(i int) (start time.Time, end time.Time, ok bool) {
+	if i < 0 || i >= len(ts.units) {
+		return time.Time{}, time.Time{}, false
+	}
+	u := ts.units[i]
+	return u.Start(), u.End(), true
+}
+
+// Len: Return count of spans (alias of Count)
+func (ts *TimeSpan) Len() int {
+	return ts.Count()
+}
+
+// EachGap: Walk each gap between consecutive units, calling fn(i, start, end). Stops early if fn returns false.
+func (ts *TimeSpan) EachGap(fn func(i int, start, end time.Time) bool) {
+	for i := 0; i < len(ts.units)-1; i++ {
+		if !fn(i, ts.units[i].End(), ts.units[i+1].Start()) {
+			return
+		}
+	}
+}
+
+// Overlaps: Check if ts and other share any common time
+func (ts *TimeSpan) Overlaps(other *TimeSpan) bool {
+	for _, u1 := range ts.units {
+		for _, u2 := range other.units {
+			if len(intersectUnits(u1, u2)) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// OverlapDuration: Return the total duration ts and other have in common
+func (ts *TimeSpan) OverlapDuration(other *TimeSpan) time.Duration {
+	// ts と other はそれぞれ内部で重複しないため、組み合わせごとの交差を単純合計してよい
+	var d time.Duration
+	for _, u1 := range ts.units {
+		for _, u2 := range other.units {
+			for _, u := range intersectUnits(u1, u2) {
+				d += u.Length()
+			}
+		}
+	}
+	return d
+}
@@ -0,0 +1,88 @@
+package timespan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Each(t *testing.T) {
+	ts := New(Time(1, 0, 0), Time(2, 0, 0))
+	ts.Add(New(Time(3, 0, 0), Time(4, 0, 0)))
+
+	var got [][2]time.Time
+	ts.Each(func(i int, start, end time.Time) bool {
+		got = append(got, [2]time.Time{start, end})
+		return true
+	})
+
+	assert.Equal(t, ts.Raw(), got)
+}
+
+func Test_Each_StopsEarly(t *testing.T) {
+	ts := New(Time(1, 0, 0), Time(2, 0, 0))
+	ts.Add(New(Time(3, 0, 0), Time(4, 0, 0)))
+
+	count := 0
+	ts.Each(func(i int, start, end time.Time) bool {
+		count++
+		return false
+	})
+
+	assert.Equal(t, 1, count)
+}
+
+func Test_At(t *testing.T) {
+	ts := New(Time(1, 0, 0), Time(2, 0, 0))
+
+	s, e, ok := ts.At(0)
+	assert.True(t, ok)
+	assert.True(t, s.Equal(Time(1, 0, 0)))
+	assert.True(t, e.Equal(Time(2, 0, 0)))
+
+	_, _, ok = ts.At(1)
+	assert.True(t, !ok)
+}
+
+func Test_Len(t *testing.T) {
+	ts := New(Time(1, 0, 0), Time(2, 0, 0))
+	ts.Add(New(Time(3, 0, 0), Time(4, 0, 0)))
+
+	assert.Equal(t, ts.Count(), ts.Len())
+}
+
+func Test_EachGap(t *testing.T) {
+	ts := New(Time(1, 0, 0), Time(2, 0, 0))
+	ts.Add(New(Time(3, 0, 0), Time(4, 0, 0)))
+	ts.Add(New(Time(5, 0, 0), Time(6, 0, 0)))
+
+	var got [][2]time.Time
+	ts.EachGap(func(i int, start, end time.Time) bool {
+		got = append(got, [2]time.Time{start, end})
+		return true
+	})
+
+	want := [][2]time.Time{
+		{Time(2, 0, 0), Time(3, 0, 0)},
+		{Time(4, 0, 0), Time(5, 0, 0)},
+	}
+	assert.Equal(t, want, got)
+}
+
+func Test_Overlaps(t *testing.T) {
+	ts1 := New(Time(1, 0, 0), Time(3, 0, 0))
+	ts2 := New(Time(2, 0, 0), Time(4, 0, 0))
+	ts3 := New(Time(5, 0, 0), Time(6, 0, 0))
+
+	assert.True(t, ts1.Overlaps(ts2))
+	assert.True(t, !ts1.Overlaps(ts3))
+}
+
+func Test_OverlapDuration(t *testing.T) {
+	ts1 := New(Time(1, 0, 0), Time(3, 0, 0))
+	ts1.Add(New(Time(5, 0, 0), Time(7, 0, 0)))
+	ts2 := New(Time(2, 0, 0), Time(6, 0, 0))
+
+	assert.Equal(t, 2*time.Hour, ts1.OverlapDuration(ts2))
+}
@@ -0,0 +1,67 @@
+package timespan
+
+import "time"
+
+// intersectUnits: Intersect units
+func intersectUnits(lhs unit, rhs unit) []unit {
+	units := make([]unit, 0, 1)
+	if !lhs.Continuous(rhs) {
+		return units
+	}
+	var start time.Time
+	if lhs.Start().After(rhs.Start()) {
+		start = lhs.Start()
+	} else {
+		start = rhs.Start()
+	}
+	var end time.Time
+	if lhs.End().Before(rhs.End()) {
+		end = lhs.End()
+	} else {
+		end = rhs.End()
+	}
+	if start.Before(end) {
+		units = append(units, newUnit(start, end))
+	}
+	return units
+}
+
+// Intersect: Intersect TimeSpans
+func (ts *TimeSpan) Intersect(other *TimeSpan) {
+	// 全組み合わせで交差を計算して正規化
+	newUnits := make([]unit, 0, ts.Count()*other.Count())
+	for _, u1 := range ts.units {
+		for _, u2 := range other.units {
+			newUnits = append(newUnits, intersectUnits(u1, u2)...)
+		}
+	}
+	ts.units = newUnits
+	ts.normalize()
+}
+
+// Xor: Take the symmetric difference with other TimeSpan (present in either but not both)
+func (ts *TimeSpan) Xor(other *TimeSpan) {
+	// (A - B) + (B - A)
+	a := &TimeSpan{units: append([]unit(nil), ts.units...)}
+	b := &TimeSpan{units: append([]unit(nil), other.units...)}
+	a.Sub(other)
+	b.Sub(ts)
+	a.Add(b)
+	ts.units = a.units
+}
+
+// Complement: Return the gaps inside bounds that are not covered by ts
+func (ts *TimeSpan) Complement(bounds *TimeSpan) *TimeSpan {
+	result := &TimeSpan{units: append([]unit(nil), bounds.units...)}
+	result.Sub(ts)
+	return result
+}
+
+// Gaps: Return the internal holes between the first and last unit
+func (ts *TimeSpan) Gaps() *TimeSpan {
+	gaps := &TimeSpan{}
+	for i := 0; i < len(ts.units)-1; i++ {
+		gaps.units = append(gaps.units, newUnit(ts.units[i].End(), ts.units[i+1].Start()))
+	}
+	return gaps
+}
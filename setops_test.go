@@ -0,0 +1,97 @@
+package timespan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Intersect(t *testing.T) {
+	ts1 := New(Time(1, 0, 0), Time(3, 0, 0))
+	ts1.Add(New(Time(5, 0, 0), Time(7, 0, 0)))
+
+	ts2 := New(Time(2, 0, 0), Time(6, 0, 0))
+
+	ts1.Intersect(ts2)
+
+	want := [][2]time.Time{
+		{Time(2, 0, 0), Time(3, 0, 0)},
+		{Time(5, 0, 0), Time(6, 0, 0)},
+	}
+	assert.Equal(t, want, ts1.Raw())
+}
+
+func Test_Intersect_NoOverlap(t *testing.T) {
+	ts1 := New(Time(1, 0, 0), Time(2, 0, 0))
+	ts2 := New(Time(3, 0, 0), Time(4, 0, 0))
+
+	ts1.Intersect(ts2)
+
+	assert.Equal(t, 0, ts1.Count())
+}
+
+func Test_Sub_MultipleUnits(t *testing.T) {
+	ts := New(Time(0, 0, 0), Time(10, 0, 0))
+	other := New(Time(2, 0, 0), Time(4, 0, 0))
+	other.Add(New(Time(6, 0, 0), Time(8, 0, 0)))
+
+	ts.Sub(other)
+
+	want := [][2]time.Time{
+		{Time(0, 0, 0), Time(2, 0, 0)},
+		{Time(4, 0, 0), Time(6, 0, 0)},
+		{Time(8, 0, 0), Time(10, 0, 0)},
+	}
+	assert.Equal(t, want, ts.Raw())
+}
+
+func Test_Xor(t *testing.T) {
+	ts1 := New(Time(1, 0, 0), Time(3, 0, 0))
+	ts2 := New(Time(2, 0, 0), Time(4, 0, 0))
+
+	ts1.Xor(ts2)
+
+	want := [][2]time.Time{
+		{Time(1, 0, 0), Time(2, 0, 0)},
+		{Time(3, 0, 0), Time(4, 0, 0)},
+	}
+	assert.Equal(t, want, ts1.Raw())
+}
+
+func Test_Complement(t *testing.T) {
+	bounds := New(Time(0, 0, 0), Time(10, 0, 0))
+
+	ts := New(Time(2, 0, 0), Time(4, 0, 0))
+	ts.Add(New(Time(6, 0, 0), Time(8, 0, 0)))
+
+	got := ts.Complement(bounds)
+
+	want := [][2]time.Time{
+		{Time(0, 0, 0), Time(2, 0, 0)},
+		{Time(4, 0, 0), Time(6, 0, 0)},
+		{Time(8, 0, 0), Time(10, 0, 0)},
+	}
+	assert.Equal(t, want, got.Raw())
+
+	// bounds と ts は変更されない
+	assert.Equal(t, 1, bounds.Count())
+	assert.Equal(t, 2, ts.Count())
+}
+
+func Test_Gaps(t *testing.T) {
+	ts := New(Time(1, 0, 0), Time(2, 0, 0))
+	ts.Add(New(Time(3, 0, 0), Time(4, 0, 0)))
+	ts.Add(New(Time(5, 0, 0), Time(6, 0, 0)))
+
+	want := [][2]time.Time{
+		{Time(2, 0, 0), Time(3, 0, 0)},
+		{Time(4, 0, 0), Time(5, 0, 0)},
+	}
+	assert.Equal(t, want, ts.Gaps().Raw())
+}
+
+func Test_Gaps_Single(t *testing.T) {
+	ts := New(Time(1, 0, 0), Time(2, 0, 0))
+	assert.Equal(t, 0, ts.Gaps().Count())
+}
@@ -101,29 +101,6 @@ func newUnit(t1 time.Time, t2 time.Time) unit {
 	}
 }
 
-// addUnits: Add units
-func addUnits(lhs unit, rhs unit) []unit {
-	units := make([]unit, 0, 2)
-	if lhs.Continuous(rhs) {
-		var start time.Time
-		if lhs.Start().Before(rhs.Start()) {
-			start = lhs.Start()
-		} else {
-			start = rhs.Start()
-		}
-		var end time.Time
-		if lhs.End().After(rhs.End()) {
-			end = lhs.End()
-		} else {
-			end = rhs.End()
-		}
-		units = append(units, newUnit(start, end))
-	} else {
-		units = append(units, lhs, rhs)
-	}
-	return units
-}
-
 // subUnits: Sub units
 func subUnits(lhs unit, rhs unit) []unit {
 	units := make([]unit, 0, 2)
@@ -222,12 +199,18 @@ func (ts *TimeSpan) Add(other *TimeSpan) {
 
 // Sub: Sub TimeSpans
 func (ts *TimeSpan) Sub(other *TimeSpan) {
-	// 全組み合わせで減算して正規化
+	// 各範囲から other の全範囲を順に減算していく（前段の結果をさらに減算する）
 	newUnits := make([]unit, 0, ts.Count()*2)
 	for _, u1 := range ts.units {
+		remain := []unit{u1}
 		for _, u2 := range other.units {
-			newUnits = append(newUnits, subUnits(u1, u2)...)
+			next := make([]unit, 0, len(remain)*2)
+			for _, r := range remain {
+				next = append(next, subUnits(r, u2)...)
+			}
+			remain = next
 		}
+		newUnits = append(newUnits, remain...)
 	}
 	ts.units = newUnits
 	ts.normalize()
@@ -293,38 +276,31 @@ func (ts *TimeSpan) normalize() {
 		return u1.Start().Equal(u2.Start()) || u1.Start().Before(u2.Start())
 	})
 
-	// 基準範囲のループ
-	for i := 0; i < len(ts.units)-1; i++ {
-		// 無効な要素はスキップ
-		if ts.units[i].Length() < 0 {
+	// ソート済みの範囲を先頭から走査し、隣接・重複するものを1回のスキャンで統合する
+	newUnits := make([]unit, 0, len(ts.units))
+	var cur unit
+	has := false
+	for _, u := range ts.units {
+		if u.Length() <= 0 {
 			continue
 		}
-
-		// 隣接範囲のループ
-		for j := i + 1; j < len(ts.units); j++ {
-			// 無効な要素はスキップ
-			if ts.units[j].Length() < 0 {
-				continue
-			}
-
-			// 隣同士の範囲を合成
-			us := addUnits(ts.units[i], ts.units[j])
-			if len(us) == 1 {
-				ts.units[i] = us[0]                             // 合成後の範囲
-				ts.units[j] = newUnit(time.Time{}, time.Time{}) // 削除
-			} else {
-				// 合成されなかった場合は基準を進める
-				break
+		switch {
+		case !has:
+			cur = u
+			has = true
+		case !cur.End().Before(u.Start()):
+			// 重複または接している = 統合
+			if u.End().After(cur.End()) {
+				cur = newUnit(cur.Start(), u.End())
 			}
+		default:
+			// 統合できない = 確定して次へ
+			newUnits = append(newUnits, cur)
+			cur = u
 		}
 	}
-
-	// 無効な要素を削除
-	newUnits := make([]unit, 0, len(ts.units))
-	for _, u := range ts.units {
-		if u.Length() > 0 {
-			newUnits = append(newUnits, u)
-		}
+	if has {
+		newUnits = append(newUnits, cur)
 	}
 	ts.units = newUnits
 }